@@ -0,0 +1,62 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrValidation  = errors.New("validation failed")
+	ErrConflict    = errors.New("conflict")
+	ErrUpstreamLLM = errors.New("upstream LLM error")
+)
+
+// Error carries a client-facing message while unwrapping to one of the
+// sentinel errors above, so callers can classify it with errors.Is.
+type Error struct {
+	Kind    error
+	Message string
+	Fields  []FieldError
+}
+
+// FieldError describes a single invalid field of a request payload.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+func NotFound(format string, args ...any) error {
+	return &Error{Kind: ErrNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func Validation(format string, args ...any) error {
+	return &Error{Kind: ErrValidation, Message: fmt.Sprintf(format, args...)}
+}
+
+// InvalidFields builds a validation error from field-level problems. The
+// message lists every field so it stays readable when logged.
+func InvalidFields(fields []FieldError) error {
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		parts = append(parts, f.Field+" "+f.Message)
+	}
+	return &Error{Kind: ErrValidation, Message: strings.Join(parts, "; "), Fields: fields}
+}
+
+func Conflict(format string, args ...any) error {
+	return &Error{Kind: ErrConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+func UpstreamLLM(format string, args ...any) error {
+	return &Error{Kind: ErrUpstreamLLM, Message: fmt.Sprintf(format, args...)}
+}
@@ -1,48 +1,119 @@
 package main
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"errors"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
 
+	"flashcards/compression"
 	"flashcards/config"
 	"flashcards/db"
 	"flashcards/handlers"
+	"flashcards/logging"
+	"flashcards/routetimeout"
 	"flashcards/services"
 
 	"github.com/gorilla/mux"
 )
 
+// version and commit are set at build time via -ldflags, see the Makefile.
+var (
+	version = "dev"
+	commit  = "unknown"
+)
+
 func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
 	cfg := config.Load()
+	slog.Info("starting flashcards API", "version", version, "commit", commit)
 
-	if cfg.DatabaseURL == "" {
-		log.Fatal("DB_URL environment variable is required")
+	if err := cfg.Validate(); err != nil {
+		slog.Error("invalid configuration", "error", err)
+		os.Exit(1)
 	}
 
 	todoRepo, err := db.NewPostgresTodoRepository(cfg.DatabaseURL)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		slog.Error("failed to initialize database", "error", err)
+		os.Exit(1)
 	}
 	defer todoRepo.Close()
 
 	todoService := services.NewTodoService(todoRepo)
 	todoHandler := handlers.NewTodoHandler(todoService)
+	infoHandler := handlers.NewInfoHandler(handlers.BuildInfo{
+		Version:   version,
+		Commit:    commit,
+		GoVersion: runtime.Version(),
+	}, cfg)
 
 	router := mux.NewRouter()
+	router.NotFoundHandler = http.HandlerFunc(handlers.NotFound)
+	router.MethodNotAllowedHandler = http.HandlerFunc(handlers.MethodNotAllowed)
+	timeouts := routetimeout.NewRegistry(cfg.RequestTimeout, cfg.LLMRequestTimeout)
 
-	router.Use(corsMiddleware)
+	router.Use(compression.Middleware(cfg.CompressionMinSize))
+	router.Use(timeouts.Middleware)
 	router.Use(jsonMiddleware)
 
 	todoHandler.RegisterRoutes(router)
+	infoHandler.RegisterRoutes(router)
 
 	router.HandleFunc("/health", healthCheckHandler).Methods("GET")
 
-	addr := ":" + cfg.Port
-	fmt.Printf("Server starting on port %s\n", cfg.Port)
+	// mux only runs Use middleware on matched routes, so logging and CORS wrap
+	// the whole router to also cover 404s, 405s and preflight requests.
+	handler := logging.Middleware(corsMiddleware(router))
+
+	server := &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		// Caps how long a slow client can take to read a response. Streaming
+		// routes clear it per request through the route timeout registry.
+		WriteTimeout: 10 * time.Minute,
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		slog.Info("server starting", "port", cfg.Port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+
+	serveFailed := false
+	select {
+	case err := <-serverErr:
+		slog.Error("server failed", "error", err)
+		serveFailed = true
+	case sig := <-stop:
+		slog.Info("shutting down", "signal", sig.String())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		slog.Error("graceful shutdown failed", "error", err)
+	}
+	slog.Info("server stopped")
 
-	if err := http.ListenAndServe(addr, router); err != nil {
-		log.Fatalf("Server failed to start: %v", err)
+	if serveFailed {
+		// os.Exit skips deferred calls, so release the database first.
+		todoRepo.Close()
+		os.Exit(1)
 	}
 }
 
@@ -50,7 +121,8 @@ func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, "+logging.RequestIDHeader)
+		w.Header().Set("Access-Control-Expose-Headers", logging.RequestIDHeader)
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
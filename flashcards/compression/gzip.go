@@ -0,0 +1,185 @@
+package compression
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var gzipWriterPool = sync.Pool{
+	New: func() any {
+		return gzip.NewWriter(nil)
+	},
+}
+
+// Middleware gzip-compresses responses for clients that accept it. Bodies are
+// buffered until minSize bytes have been written; smaller responses are sent
+// as-is. Server-sent event streams and responses that already carry a
+// Content-Encoding are passed through untouched and unbuffered.
+func Middleware(minSize int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Accept-Encoding")
+
+			if r.Method == http.MethodHead || !acceptsGzip(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			gw := &gzipResponseWriter{
+				ResponseWriter: w,
+				minSize:        minSize,
+				status:         http.StatusOK,
+			}
+			defer gw.finish()
+
+			next.ServeHTTP(gw, r)
+		})
+	}
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		encoding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if strings.TrimSpace(encoding) == "gzip" && qValue(params) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// qValue returns the quality weight from an Accept-Encoding entry's
+// parameters, e.g. "q=0.5". It defaults to 1 when absent and returns 0 for
+// malformed values so that unclear preferences are not compressed.
+func qValue(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if !strings.EqualFold(strings.TrimSpace(key), "q") {
+			continue
+		}
+
+		q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0
+		}
+		return q
+	}
+	return 1
+}
+
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	minSize int
+	status  int
+	buf     []byte
+	gz      *gzip.Writer
+	// started is set once headers have been sent, either compressed (gz is
+	// non-nil) or as a plain passthrough.
+	started bool
+}
+
+func (w *gzipResponseWriter) WriteHeader(status int) {
+	if w.started {
+		return
+	}
+	if status < http.StatusOK {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.status = status
+	if !w.compressible() {
+		w.startPlain()
+	}
+}
+
+func (w *gzipResponseWriter) Write(p []byte) (int, error) {
+	if !w.started && !w.compressible() {
+		w.startPlain()
+	}
+
+	if w.started {
+		if w.gz != nil {
+			return w.gz.Write(p)
+		}
+		return w.ResponseWriter.Write(p)
+	}
+
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= w.minSize {
+		if err := w.startGzip(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends whatever has been buffered so far. Flushing before the size
+// threshold is reached commits the response to being uncompressed, which
+// keeps streaming handlers unbuffered.
+func (w *gzipResponseWriter) Flush() {
+	if !w.started {
+		w.startPlain()
+		w.ResponseWriter.Write(w.buf)
+		w.buf = nil
+	}
+	if w.gz != nil {
+		w.gz.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *gzipResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *gzipResponseWriter) compressible() bool {
+	h := w.Header()
+	if h.Get("Content-Encoding") != "" {
+		return false
+	}
+	if strings.HasPrefix(h.Get("Content-Type"), "text/event-stream") {
+		return false
+	}
+	return w.status != http.StatusNoContent && w.status != http.StatusNotModified
+}
+
+func (w *gzipResponseWriter) startPlain() {
+	w.started = true
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+func (w *gzipResponseWriter) startGzip() error {
+	w.started = true
+
+	h := w.Header()
+	h.Set("Content-Encoding", "gzip")
+	h.Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.status)
+
+	w.gz = gzipWriterPool.Get().(*gzip.Writer)
+	w.gz.Reset(w.ResponseWriter)
+
+	_, err := w.gz.Write(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *gzipResponseWriter) finish() {
+	if !w.started {
+		w.startPlain()
+		if len(w.buf) > 0 {
+			w.ResponseWriter.Write(w.buf)
+		}
+		return
+	}
+	if w.gz != nil {
+		w.gz.Close()
+		w.gz.Reset(nil)
+		gzipWriterPool.Put(w.gz)
+		w.gz = nil
+	}
+}
@@ -0,0 +1,154 @@
+package compression
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testMinSize = 100
+
+func serve(t *testing.T, acceptEncoding string, handler http.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	rec := httptest.NewRecorder()
+	Middleware(testMinSize)(handler).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_CompressesBodyAboveThreshold(t *testing.T) {
+	body := strings.Repeat("a", testMinSize*5)
+
+	rec := serve(t, "deflate, gzip;q=0.5", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(body[:testMinSize/2]))
+		w.Write([]byte(body[testMinSize/2:]))
+	})
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusCreated)
+	}
+	if got := rec.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("Content-Encoding = %q, want gzip", got)
+	}
+	if rec.Body.Len() >= len(body) {
+		t.Errorf("compressed body is %d bytes, want fewer than %d", rec.Body.Len(), len(body))
+	}
+
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	decoded, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("reading gzip body: %v", err)
+	}
+	if string(decoded) != body {
+		t.Errorf("decoded body does not round-trip (got %d bytes, want %d)", len(decoded), len(body))
+	}
+}
+
+func TestMiddleware_PassesThroughUncompressed(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		handler        http.HandlerFunc
+		wantStatus     int
+		wantBody       string
+		wantFlushed    bool
+	}{
+		{
+			name:           "body below threshold",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("small"))
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "small",
+		},
+		{
+			name:           "event stream is not buffered",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.Write([]byte("data: " + strings.Repeat("b", testMinSize*2) + "\n\n"))
+				w.(http.Flusher).Flush()
+			},
+			wantStatus:  http.StatusOK,
+			wantBody:    "data: " + strings.Repeat("b", testMinSize*2) + "\n\n",
+			wantFlushed: true,
+		},
+		{
+			name:           "content encoding already set",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Encoding", "br")
+				w.Write([]byte(strings.Repeat("c", testMinSize*2)))
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   strings.Repeat("c", testMinSize*2),
+		},
+		{
+			name:           "no content",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:           "gzip explicitly refused",
+			acceptEncoding: "gzip;q=0",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(strings.Repeat("d", testMinSize*2)))
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   strings.Repeat("d", testMinSize*2),
+		},
+		{
+			name:           "gzip explicitly refused with decimal q",
+			acceptEncoding: "gzip;q=0.0",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(strings.Repeat("d", testMinSize*2)))
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   strings.Repeat("d", testMinSize*2),
+		},
+		{
+			name:           "gzip explicitly refused with spaced q",
+			acceptEncoding: "gzip; q=0.000",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(strings.Repeat("d", testMinSize*2)))
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   strings.Repeat("d", testMinSize*2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, tt.acceptEncoding, tt.handler)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Content-Encoding"); got == "gzip" {
+				t.Errorf("Content-Encoding = gzip, want response left uncompressed")
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+			if rec.Flushed != tt.wantFlushed {
+				t.Errorf("Flushed = %v, want %v", rec.Flushed, tt.wantFlushed)
+			}
+		})
+	}
+}
@@ -1,36 +1,80 @@
 package config
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	DatabaseURL string
-	Port        string
+	DatabaseURL     string
+	Port            string
+	ShutdownTimeout time.Duration
+	// RequestTimeout bounds plain CRUD routes; LLMRequestTimeout bounds
+	// non-streaming routes that wait on a model. Streaming routes are exempt.
+	RequestTimeout    time.Duration
+	LLMRequestTimeout time.Duration
+	// CompressionMinSize is the smallest response body, in bytes, that is
+	// gzip-compressed.
+	CompressionMinSize int
+
+	// parseErrs collects values that were set but could not be parsed, so
+	// Validate can report them together with missing settings.
+	parseErrs []error
 }
 
 func Load() *Config {
 	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found or error loading .env file")
+		slog.Info("no .env file found or error loading .env file")
 	}
 
 	config := &Config{
-		DatabaseURL: getEnv("DB_URL"),
+		DatabaseURL: os.Getenv("DB_URL"),
 		Port:        getEnvWithDefault("PORT", "8080"),
 	}
+	config.ShutdownTimeout = config.getDurationWithDefault("SHUTDOWN_TIMEOUT", 30*time.Second)
+	config.RequestTimeout = config.getDurationWithDefault("REQUEST_TIMEOUT", 10*time.Second)
+	config.LLMRequestTimeout = config.getDurationWithDefault("LLM_REQUEST_TIMEOUT", 120*time.Second)
+	config.CompressionMinSize = config.getIntWithDefault("COMPRESSION_MIN_SIZE", 1024)
 
 	return config
 }
 
-func getEnv(key string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		panic("Required environment variable not set: " + key)
+// Validate reports every missing or invalid setting at once instead of
+// failing on the first one.
+func (c *Config) Validate() error {
+	errs := append([]error{}, c.parseErrs...)
+
+	if c.DatabaseURL == "" {
+		errs = append(errs, errors.New("DB_URL is required"))
+	}
+
+	if port, err := strconv.Atoi(c.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("PORT must be a number between 1 and 65535, got %q", c.Port))
+	}
+
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("SHUTDOWN_TIMEOUT must be positive"))
 	}
-	return value
+
+	if c.RequestTimeout <= 0 {
+		errs = append(errs, errors.New("REQUEST_TIMEOUT must be positive"))
+	}
+
+	if c.LLMRequestTimeout <= 0 {
+		errs = append(errs, errors.New("LLM_REQUEST_TIMEOUT must be positive"))
+	}
+
+	if c.CompressionMinSize < 0 {
+		errs = append(errs, errors.New("COMPRESSION_MIN_SIZE cannot be negative"))
+	}
+
+	return errors.Join(errs...)
 }
 
 func getEnvWithDefault(key, defaultValue string) string {
@@ -39,3 +83,31 @@ func getEnvWithDefault(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func (c *Config) getDurationWithDefault(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		c.parseErrs = append(c.parseErrs, fmt.Errorf("%s must be a duration such as 30s, got %q", key, value))
+		return defaultValue
+	}
+	return d
+}
+
+func (c *Config) getIntWithDefault(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		c.parseErrs = append(c.parseErrs, fmt.Errorf("%s must be an integer, got %q", key, value))
+		return defaultValue
+	}
+	return n
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"flashcards/apperrors"
 	"flashcards/models"
 
 	_ "github.com/lib/pq"
@@ -62,7 +63,7 @@ func (r *PostgresTodoRepository) GetTodoByID(id int) (*models.Todo, error) {
 	err := row.Scan(&todo.ID, &todo.Title, &todo.Description, &todo.Completed, &todo.CreatedAt, &todo.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("todo with id %d not found", id)
+			return nil, apperrors.NotFound("todo with id %d not found", id)
 		}
 		return nil, fmt.Errorf("failed to get todo: %w", err)
 	}
@@ -101,7 +102,7 @@ func (r *PostgresTodoRepository) GetAllTodos() ([]*models.Todo, error) {
 
 func (r *PostgresTodoRepository) UpdateTodo(id int, updates map[string]any) error {
 	if len(updates) == 0 {
-		return fmt.Errorf("no updates provided")
+		return apperrors.Validation("no updates provided")
 	}
 
 	query := "UPDATE gocourse.todos SET "
@@ -131,7 +132,7 @@ func (r *PostgresTodoRepository) UpdateTodo(id int, updates map[string]any) erro
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("todo with id %d not found", id)
+		return apperrors.NotFound("todo with id %d not found", id)
 	}
 
 	return nil
@@ -151,7 +152,7 @@ func (r *PostgresTodoRepository) DeleteTodo(id int) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("todo with id %d not found", id)
+		return apperrors.NotFound("todo with id %d not found", id)
 	}
 
 	return nil
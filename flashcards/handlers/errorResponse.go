@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"flashcards/apperrors"
+	"flashcards/logging"
+)
+
+type errorResponse struct {
+	Error  string                 `json:"error"`
+	Code   string                 `json:"code"`
+	Errors []apperrors.FieldError `json:"errors,omitempty"`
+}
+
+// respondError maps typed application errors to HTTP status codes. Errors
+// that carry no known kind are treated as internal and reported with the
+// fallback message so that database or driver details are not leaked.
+func respondError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "not_found", err.Error())
+	case errors.Is(err, apperrors.ErrValidation):
+		writeValidationResponse(w, err)
+	case errors.Is(err, apperrors.ErrConflict):
+		writeErrorResponse(w, http.StatusConflict, "conflict", err.Error())
+	case errors.Is(err, apperrors.ErrUpstreamLLM):
+		writeErrorResponse(w, http.StatusBadGateway, "upstream_llm_error", err.Error())
+	default:
+		logging.FromContext(r.Context()).Error(fallback, "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "internal_error", fallback)
+	}
+}
+
+// NotFound answers requests that match no route, including IDs rejected by
+// the {id:[0-9]+} route constraints, with the standard error envelope.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	writeErrorResponse(w, http.StatusNotFound, "not_found", "resource not found")
+}
+
+// MethodNotAllowed answers requests whose path matches a route registered
+// for other methods.
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeErrorResponse(w, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed")
+}
+
+func writeErrorResponse(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(errorResponse{Error: message, Code: code})
+}
+
+func writeValidationResponse(w http.ResponseWriter, err error) {
+	resp := errorResponse{Error: err.Error(), Code: "validation_error"}
+
+	var appErr *apperrors.Error
+	if errors.As(err, &appErr) {
+		resp.Errors = appErr.Fields
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(resp)
+}
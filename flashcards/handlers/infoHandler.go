@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"flashcards/config"
+
+	"github.com/gorilla/mux"
+)
+
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	GoVersion string `json:"goVersion"`
+}
+
+// databaseSummary says whether a database is configured without exposing
+// any part of the connection string beyond its scheme.
+type databaseSummary struct {
+	Configured bool   `json:"configured"`
+	Scheme     string `json:"scheme,omitempty"`
+}
+
+type configSummary struct {
+	Database           databaseSummary `json:"database"`
+	Port               string          `json:"port"`
+	ShutdownTimeout    string          `json:"shutdownTimeout"`
+	RequestTimeout     string          `json:"requestTimeout"`
+	LLMRequestTimeout  string          `json:"llmRequestTimeout"`
+	CompressionMinSize int             `json:"compressionMinSize"`
+}
+
+type infoResponse struct {
+	Build  BuildInfo     `json:"build"`
+	Config configSummary `json:"config"`
+}
+
+type InfoHandler struct {
+	build BuildInfo
+	cfg   *config.Config
+}
+
+func NewInfoHandler(build BuildInfo, cfg *config.Config) *InfoHandler {
+	return &InfoHandler{build: build, cfg: cfg}
+}
+
+func (h *InfoHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/admin/info", h.GetInfo).Methods("GET")
+}
+
+func (h *InfoHandler) GetInfo(w http.ResponseWriter, r *http.Request) {
+	resp := infoResponse{
+		Build: h.build,
+		Config: configSummary{
+			Database:           redactDatabaseURL(h.cfg.DatabaseURL),
+			Port:               h.cfg.Port,
+			ShutdownTimeout:    h.cfg.ShutdownTimeout.String(),
+			RequestTimeout:     h.cfg.RequestTimeout.String(),
+			LLMRequestTimeout:  h.cfg.LLMRequestTimeout.String(),
+			CompressionMinSize: h.cfg.CompressionMinSize,
+		},
+	}
+
+	h.writeJSONResponse(w, http.StatusOK, resp)
+}
+
+func (h *InfoHandler) writeJSONResponse(w http.ResponseWriter, statusCode int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(data)
+}
+
+// redactDatabaseURL reduces a connection string to whether it is set and its
+// URL scheme. The endpoint is served to any origin, so user, host, port and
+// database name are withheld along with credentials. Keyword/value DSNs
+// report no scheme.
+func redactDatabaseURL(raw string) databaseSummary {
+	if raw == "" {
+		return databaseSummary{}
+	}
+
+	summary := databaseSummary{Configured: true}
+	if u, err := url.Parse(raw); err == nil {
+		summary.Scheme = u.Scheme
+	}
+	return summary
+}
@@ -3,10 +3,11 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
-	"strconv"
 
+	"flashcards/httpid"
 	"flashcards/models"
 	"flashcards/services"
+	"flashcards/validation"
 
 	"github.com/gorilla/mux"
 )
@@ -30,13 +31,18 @@ func (h *TodoHandler) RegisterRoutes(router *mux.Router) {
 func (h *TodoHandler) CreateTodo(w http.ResponseWriter, r *http.Request) {
 	var req models.CreateTodoRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON payload")
+		writeErrorResponse(w, http.StatusBadRequest, "invalid_json", "Invalid JSON payload")
+		return
+	}
+
+	if err := validation.ValidateCreateTodo(&req); err != nil {
+		respondError(w, r, err, "Invalid todo payload")
 		return
 	}
 
 	todo, err := h.service.CreateTodo(&req)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		respondError(w, r, err, "Failed to create todo")
 		return
 	}
 
@@ -46,7 +52,7 @@ func (h *TodoHandler) CreateTodo(w http.ResponseWriter, r *http.Request) {
 func (h *TodoHandler) GetAllTodos(w http.ResponseWriter, r *http.Request) {
 	todos, err := h.service.GetAllTodos()
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve todos")
+		respondError(w, r, err, "Failed to retrieve todos")
 		return
 	}
 
@@ -54,20 +60,15 @@ func (h *TodoHandler) GetAllTodos(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TodoHandler) GetTodoByID(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
+	id, err := httpid.ParseID(r, "id")
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid todo ID")
+		respondError(w, r, err, "Invalid todo ID")
 		return
 	}
 
 	todo, err := h.service.GetTodoByID(id)
 	if err != nil {
-		if containsNotFound(err.Error()) {
-			h.writeErrorResponse(w, http.StatusNotFound, err.Error())
-		} else {
-			h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve todo")
-		}
+		respondError(w, r, err, "Failed to retrieve todo")
 		return
 	}
 
@@ -75,26 +76,26 @@ func (h *TodoHandler) GetTodoByID(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TodoHandler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
+	id, err := httpid.ParseID(r, "id")
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid todo ID")
+		respondError(w, r, err, "Invalid todo ID")
 		return
 	}
 
 	var req models.UpdateTodoRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON payload")
+		writeErrorResponse(w, http.StatusBadRequest, "invalid_json", "Invalid JSON payload")
+		return
+	}
+
+	if err := validation.ValidateUpdateTodo(&req); err != nil {
+		respondError(w, r, err, "Invalid todo payload")
 		return
 	}
 
 	todo, err := h.service.UpdateTodo(id, &req)
 	if err != nil {
-		if containsNotFound(err.Error()) {
-			h.writeErrorResponse(w, http.StatusNotFound, err.Error())
-		} else {
-			h.writeErrorResponse(w, http.StatusBadRequest, err.Error())
-		}
+		respondError(w, r, err, "Failed to update todo")
 		return
 	}
 
@@ -102,20 +103,15 @@ func (h *TodoHandler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TodoHandler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	id, err := strconv.Atoi(vars["id"])
+	id, err := httpid.ParseID(r, "id")
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid todo ID")
+		respondError(w, r, err, "Invalid todo ID")
 		return
 	}
 
 	err = h.service.DeleteTodo(id)
 	if err != nil {
-		if containsNotFound(err.Error()) {
-			h.writeErrorResponse(w, http.StatusNotFound, err.Error())
-		} else {
-			h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to delete todo")
-		}
+		respondError(w, r, err, "Failed to delete todo")
 		return
 	}
 
@@ -127,14 +123,3 @@ func (h *TodoHandler) writeJSONResponse(w http.ResponseWriter, statusCode int, d
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(data)
 }
-
-func (h *TodoHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, message string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(map[string]string{"error": message})
-}
-
-func containsNotFound(message string) bool {
-	return len(message) > 0 && (message[len(message)-9:] == "not found" ||
-		message[:len("todo with id")] == "todo with id")
-}
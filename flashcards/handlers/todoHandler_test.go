@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestTodoHandler_InvalidIDs(t *testing.T) {
+	router := mux.NewRouter()
+	router.NotFoundHandler = http.HandlerFunc(NotFound)
+	// Invalid IDs are rejected before the service is reached.
+	NewTodoHandler(nil).RegisterRoutes(router)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "zero", path: "/todos/0", wantStatus: http.StatusBadRequest, wantCode: "validation_error"},
+		{name: "overflow", path: "/todos/99999999999999999999", wantStatus: http.StatusBadRequest, wantCode: "validation_error"},
+		// The {id:[0-9]+} constraint keeps these from matching any route.
+		{name: "negative", path: "/todos/-1", wantStatus: http.StatusNotFound, wantCode: "not_found"},
+		{name: "non-numeric", path: "/todos/abc", wantStatus: http.StatusNotFound, wantCode: "not_found"},
+	}
+
+	for _, tt := range tests {
+		for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+			t.Run(tt.name+"/"+method, func(t *testing.T) {
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, httptest.NewRequest(method, tt.path, nil))
+
+				if rec.Code != tt.wantStatus {
+					t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+				}
+				var body errorResponse
+				if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+					t.Fatalf("decoding error body: %v", err)
+				}
+				if body.Code != tt.wantCode {
+					t.Errorf("code = %q, want %q", body.Code, tt.wantCode)
+				}
+				if body.Error == "" {
+					t.Error("error message is empty")
+				}
+			})
+		}
+	}
+}
+
+func TestTodoHandler_MethodNotAllowed(t *testing.T) {
+	router := mux.NewRouter()
+	router.MethodNotAllowedHandler = http.HandlerFunc(MethodNotAllowed)
+	NewTodoHandler(nil).RegisterRoutes(router)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/todos/1", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+
+	var body errorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding error body: %v", err)
+	}
+	if body.Code != "method_not_allowed" || body.Error == "" {
+		t.Errorf("body = %+v, want {error, code: method_not_allowed}", body)
+	}
+}
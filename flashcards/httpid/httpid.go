@@ -0,0 +1,33 @@
+package httpid
+
+import (
+	"net/http"
+	"strconv"
+
+	"flashcards/apperrors"
+
+	"github.com/gorilla/mux"
+)
+
+// ParseID reads the named path variable and returns it as a positive int.
+// Routes should still constrain the variable with {name:[0-9]+} so that
+// non-numeric paths never reach the handler.
+// Missing, non-numeric, overflowing and non-positive values yield a
+// validation error, which handlers report as a 400.
+func ParseID(r *http.Request, name string) (int, error) {
+	raw, ok := mux.Vars(r)[name]
+	if !ok || raw == "" {
+		return 0, apperrors.Validation("missing %s", name)
+	}
+
+	id, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, apperrors.Validation("invalid %s: %q", name, raw)
+	}
+
+	if id <= 0 {
+		return 0, apperrors.Validation("invalid %s: must be positive", name)
+	}
+
+	return id, nil
+}
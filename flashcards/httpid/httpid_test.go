@@ -0,0 +1,48 @@
+package httpid
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"flashcards/apperrors"
+
+	"github.com/gorilla/mux"
+)
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		name    string
+		vars    map[string]string
+		want    int
+		wantErr bool
+	}{
+		{name: "positive", vars: map[string]string{"id": "42"}, want: 42},
+		{name: "zero", vars: map[string]string{"id": "0"}, wantErr: true},
+		{name: "negative", vars: map[string]string{"id": "-1"}, wantErr: true},
+		{name: "overflow", vars: map[string]string{"id": "99999999999999999999"}, wantErr: true},
+		{name: "non-numeric", vars: map[string]string{"id": "abc"}, wantErr: true},
+		{name: "empty", vars: map[string]string{"id": ""}, wantErr: true},
+		{name: "missing", vars: map[string]string{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := mux.SetURLVars(httptest.NewRequest("GET", "/", nil), tt.vars)
+
+			got, err := ParseID(r, "id")
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrValidation) {
+					t.Fatalf("ParseID() error = %v, want a validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseID() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseID() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
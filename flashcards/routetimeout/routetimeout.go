@@ -0,0 +1,175 @@
+// Package routetimeout bounds how long handlers may run, per class of route.
+//
+// The deadline is enforced by abandoning the handler: the client receives a
+// 504 and later writes are discarded, but the handler goroutine keeps running
+// until it returns. Code that does not observe r.Context(), such as the todo
+// service and repository, will finish its database work after the 504 is sent.
+package routetimeout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"flashcards/logging"
+
+	"github.com/gorilla/mux"
+)
+
+// Class groups routes that share a timeout budget.
+type Class int
+
+const (
+	// ClassCRUD is the default for routes that were not classified.
+	ClassCRUD Class = iota
+	// ClassLLM covers non-streaming routes that wait on a model response.
+	ClassLLM
+	// ClassStreaming routes write incrementally and are never timed out.
+	ClassStreaming
+)
+
+// Registry maps routes to timeout classes. Routes must be classified while
+// the router is being built; the registry is read-only once serving starts.
+type Registry struct {
+	timeouts map[Class]time.Duration
+	routes   map[*mux.Route]Class
+}
+
+func NewRegistry(crudTimeout, llmTimeout time.Duration) *Registry {
+	return &Registry{
+		timeouts: map[Class]time.Duration{
+			ClassCRUD: crudTimeout,
+			ClassLLM:  llmTimeout,
+		},
+		routes: make(map[*mux.Route]Class),
+	}
+}
+
+// Set classifies a route and returns it so the call can wrap route
+// registration, e.g. reg.Set(router.HandleFunc(...), routetimeout.ClassLLM).
+func (reg *Registry) Set(route *mux.Route, class Class) *mux.Route {
+	reg.routes[route] = class
+	return route
+}
+
+func (reg *Registry) timeoutFor(r *http.Request) time.Duration {
+	class := ClassCRUD
+	if route := mux.CurrentRoute(r); route != nil {
+		if c, ok := reg.routes[route]; ok {
+			class = c
+		}
+	}
+	return reg.timeouts[class]
+}
+
+// Middleware enforces the matched route's timeout. The handler's output is
+// buffered; if the deadline passes first the client receives a 504 with the
+// standard error envelope and anything the handler writes afterwards is
+// discarded. Streaming routes bypass the middleware and have the server's
+// write deadline cleared so long-lived streams are not cut off.
+func (reg *Registry) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timeout := reg.timeoutFor(r)
+		if timeout <= 0 {
+			// Not every ResponseWriter supports deadlines (e.g. in tests);
+			// the stream then simply keeps the server default.
+			http.NewResponseController(w).SetWriteDeadline(time.Time{})
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		r = r.WithContext(ctx)
+
+		tw := &timeoutWriter{header: make(http.Header), status: http.StatusOK}
+		done := make(chan struct{})
+		panicChan := make(chan any, 1)
+
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicChan <- p
+				}
+			}()
+			next.ServeHTTP(tw, r)
+			close(done)
+		}()
+
+		select {
+		case p := <-panicChan:
+			panic(p)
+		case <-done:
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+
+			dst := w.Header()
+			for k, v := range tw.header {
+				dst[k] = v
+			}
+			w.WriteHeader(tw.status)
+			w.Write(tw.buf.Bytes())
+		case <-ctx.Done():
+			tw.mu.Lock()
+			tw.timedOut = true
+			tw.mu.Unlock()
+
+			logger := logging.FromContext(r.Context())
+
+			// ctx also ends when the client goes away; that is not a timeout
+			// and there is nobody left to send a response to.
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				logger.Debug("request canceled by client", "error", ctx.Err())
+				return
+			}
+
+			logger.Warn("request timed out", "timeout", timeout)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusGatewayTimeout)
+			json.NewEncoder(w).Encode(map[string]string{
+				"error": "request timed out",
+				"code":  "timeout",
+			})
+		}
+	})
+}
+
+type timeoutWriter struct {
+	mu          sync.Mutex
+	header      http.Header
+	buf         bytes.Buffer
+	status      int
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) Write(p []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	tw.wroteHeader = true
+	return tw.buf.Write(p)
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if tw.timedOut || tw.wroteHeader {
+		return
+	}
+	tw.wroteHeader = true
+	tw.status = status
+}
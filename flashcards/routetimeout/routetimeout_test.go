@@ -0,0 +1,119 @@
+package routetimeout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	testCRUDTimeout = 20 * time.Millisecond
+	testLLMTimeout  = 5 * time.Second
+)
+
+func newTestRouter(t *testing.T) *mux.Router {
+	t.Helper()
+
+	// release unblocks handlers abandoned by a timeout so they do not leak
+	// past the test.
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	slow := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-time.After(10 * testCRUDTimeout):
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("done"))
+	}
+
+	router := mux.NewRouter()
+	reg := NewRegistry(testCRUDTimeout, testLLMTimeout)
+	router.Use(reg.Middleware)
+
+	router.HandleFunc("/crud", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	reg.Set(router.HandleFunc("/llm", slow), ClassLLM)
+	reg.Set(router.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Context().Deadline(); ok {
+			t.Error("streaming route received a context deadline")
+		}
+		slow(w, r)
+	}), ClassStreaming)
+	router.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	return router
+}
+
+func TestMiddleware_CRUDRouteTimesOut(t *testing.T) {
+	router := newTestRouter(t)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/crud", nil))
+
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusGatewayTimeout)
+	}
+
+	var body map[string]string
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if body["code"] != "timeout" || body["error"] == "" {
+		t.Errorf("body = %v, want {error, code: timeout}", body)
+	}
+}
+
+func TestMiddleware_ClientCancelIsNotATimeout(t *testing.T) {
+	router := newTestRouter(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/crud", nil).WithContext(ctx))
+
+	if rec.Body.Len() != 0 || rec.Header().Get("Content-Type") != "" {
+		t.Errorf("canceled request got a response: %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+func TestMiddleware_RoutesUseTheirClassBudget(t *testing.T) {
+	router := newTestRouter(t)
+
+	for _, path := range []string{"/llm", "/stream"} {
+		t.Run(path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want %d (route outlived the CRUD budget)", rec.Code, http.StatusCreated)
+			}
+			if got := rec.Body.String(); got != "done" {
+				t.Errorf("body = %q, want %q", got, "done")
+			}
+		})
+	}
+}
+
+func TestMiddleware_PropagatesPanics(t *testing.T) {
+	router := newTestRouter(t)
+
+	defer func() {
+		if p := recover(); p != "boom" {
+			t.Errorf("recovered %v, want the handler's panic", p)
+		}
+	}()
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/panic", nil))
+	t.Fatal("ServeHTTP returned without panicking")
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"flashcards/apperrors"
 	"flashcards/db"
 	"flashcards/models"
+	"flashcards/validation"
 )
 
 type TodoService struct {
@@ -17,7 +19,7 @@ func NewTodoService(repo db.TodoRepository) *TodoService {
 }
 
 func (s *TodoService) CreateTodo(req *models.CreateTodoRequest) (*models.Todo, error) {
-	if err := s.validateCreateRequest(req); err != nil {
+	if err := validation.ValidateCreateTodo(req); err != nil {
 		return nil, err
 	}
 
@@ -36,7 +38,7 @@ func (s *TodoService) CreateTodo(req *models.CreateTodoRequest) (*models.Todo, e
 
 func (s *TodoService) GetTodoByID(id int) (*models.Todo, error) {
 	if id <= 0 {
-		return nil, fmt.Errorf("invalid todo ID: %d", id)
+		return nil, apperrors.Validation("invalid todo ID: %d", id)
 	}
 
 	todo, err := s.repo.GetTodoByID(id)
@@ -58,21 +60,17 @@ func (s *TodoService) GetAllTodos() ([]*models.Todo, error) {
 
 func (s *TodoService) UpdateTodo(id int, req *models.UpdateTodoRequest) (*models.Todo, error) {
 	if id <= 0 {
-		return nil, fmt.Errorf("invalid todo ID: %d", id)
+		return nil, apperrors.Validation("invalid todo ID: %d", id)
 	}
 
-	if err := s.validateUpdateRequest(req); err != nil {
+	if err := validation.ValidateUpdateTodo(req); err != nil {
 		return nil, err
 	}
 
 	updates := make(map[string]any)
 
 	if req.Title != nil {
-		trimmedTitle := strings.TrimSpace(*req.Title)
-		if trimmedTitle == "" {
-			return nil, fmt.Errorf("title cannot be empty")
-		}
-		updates["title"] = trimmedTitle
+		updates["title"] = strings.TrimSpace(*req.Title)
 	}
 
 	if req.Description != nil {
@@ -83,10 +81,6 @@ func (s *TodoService) UpdateTodo(id int, req *models.UpdateTodoRequest) (*models
 		updates["completed"] = *req.Completed
 	}
 
-	if len(updates) == 0 {
-		return nil, fmt.Errorf("no valid updates provided")
-	}
-
 	if err := s.repo.UpdateTodo(id, updates); err != nil {
 		return nil, err
 	}
@@ -96,44 +90,8 @@ func (s *TodoService) UpdateTodo(id int, req *models.UpdateTodoRequest) (*models
 
 func (s *TodoService) DeleteTodo(id int) error {
 	if id <= 0 {
-		return fmt.Errorf("invalid todo ID: %d", id)
+		return apperrors.Validation("invalid todo ID: %d", id)
 	}
 
 	return s.repo.DeleteTodo(id)
 }
-
-func (s *TodoService) validateCreateRequest(req *models.CreateTodoRequest) error {
-	if req == nil {
-		return fmt.Errorf("request cannot be nil")
-	}
-
-	title := strings.TrimSpace(req.Title)
-	if title == "" {
-		return fmt.Errorf("title is required")
-	}
-
-	if len(title) > 255 {
-		return fmt.Errorf("title cannot exceed 255 characters")
-	}
-
-	return nil
-}
-
-func (s *TodoService) validateUpdateRequest(req *models.UpdateTodoRequest) error {
-	if req == nil {
-		return fmt.Errorf("request cannot be nil")
-	}
-
-	if req.Title == nil && req.Description == nil && req.Completed == nil {
-		return fmt.Errorf("at least one field must be provided for update")
-	}
-
-	if req.Title != nil {
-		title := strings.TrimSpace(*req.Title)
-		if len(title) > 255 {
-			return fmt.Errorf("title cannot exceed 255 characters")
-		}
-	}
-
-	return nil
-}
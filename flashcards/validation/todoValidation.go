@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"flashcards/apperrors"
+	"flashcards/models"
+)
+
+const maxTitleLength = 255
+
+// ValidateCreateTodo checks a create payload and returns a validation error
+// listing every invalid field, or nil if the request is valid.
+func ValidateCreateTodo(req *models.CreateTodoRequest) error {
+	if req == nil {
+		return apperrors.Validation("request cannot be nil")
+	}
+
+	var fields []apperrors.FieldError
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		fields = append(fields, apperrors.FieldError{Field: "title", Message: "is required"})
+	} else if len(title) > maxTitleLength {
+		fields = append(fields, apperrors.FieldError{Field: "title", Message: fmt.Sprintf("cannot exceed %d characters", maxTitleLength)})
+	}
+
+	return result(fields)
+}
+
+// ValidateUpdateTodo checks a partial update payload. At least one field must
+// be present and a provided title must not be blank.
+func ValidateUpdateTodo(req *models.UpdateTodoRequest) error {
+	if req == nil {
+		return apperrors.Validation("request cannot be nil")
+	}
+
+	if req.Title == nil && req.Description == nil && req.Completed == nil {
+		return apperrors.InvalidFields([]apperrors.FieldError{
+			{Field: "body", Message: "at least one of title, description, completed is required"},
+		})
+	}
+
+	var fields []apperrors.FieldError
+
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" {
+			fields = append(fields, apperrors.FieldError{Field: "title", Message: "cannot be empty"})
+		} else if len(title) > maxTitleLength {
+			fields = append(fields, apperrors.FieldError{Field: "title", Message: fmt.Sprintf("cannot exceed %d characters", maxTitleLength)})
+		}
+	}
+
+	return result(fields)
+}
+
+func result(fields []apperrors.FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return apperrors.InvalidFields(fields)
+}